@@ -0,0 +1,83 @@
+package gocron
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// JobWrapper decorates a Job, e.g. to add panic recovery or overlap
+// prevention. Wrappers are composed by Chain in the order they're given.
+type JobWrapper func(Job) Job
+
+// Chain is an ordered list of JobWrappers applied to a Job before it is
+// scheduled.
+type Chain struct {
+	wrappers []JobWrapper
+}
+
+// NewChain returns a Chain consisting of the given JobWrappers.
+func NewChain(wrappers ...JobWrapper) Chain {
+	return Chain{wrappers: wrappers}
+}
+
+// Then decorates the given job with all of the wrappers in the chain, in the
+// order they were given to NewChain.
+func (c Chain) Then(j Job) Job {
+	for i := len(c.wrappers) - 1; i >= 0; i-- {
+		j = c.wrappers[i](j)
+	}
+	return j
+}
+
+// Recover wraps a Job so that a panic during Run is logged instead of
+// crashing the process.
+func Recover(logger Logger) JobWrapper {
+	return func(j Job) Job {
+		return FuncJob(func() {
+			defer func() {
+				if r := recover(); r != nil {
+					const size = 64 << 10
+					buf := make([]byte, size)
+					buf = buf[:runtime.Stack(buf, false)]
+					logger.Printf("panic running job: %v\n%s", r, buf)
+				}
+			}()
+			j.Run()
+		})
+	}
+}
+
+// DelayIfStillRunning wraps a Job so that a new run waits for the previous
+// run to finish, rather than overlapping it.
+func DelayIfStillRunning(logger Logger) JobWrapper {
+	return func(j Job) Job {
+		var mu sync.Mutex
+		return FuncJob(func() {
+			start := time.Now()
+			mu.Lock()
+			defer mu.Unlock()
+			if dur := time.Since(start); dur > time.Minute {
+				logger.Printf("job delayed %v by a still-running previous execution", dur)
+			}
+			j.Run()
+		})
+	}
+}
+
+// SkipIfStillRunning wraps a Job so that a new run is skipped entirely if
+// the previous run hasn't finished yet, rather than overlapping or queuing.
+func SkipIfStillRunning(logger Logger) JobWrapper {
+	return func(j Job) Job {
+		var running int32
+		return FuncJob(func() {
+			if !atomic.CompareAndSwapInt32(&running, 0, 1) {
+				logger.Printf("skipping run: previous execution still in progress")
+				return
+			}
+			defer atomic.StoreInt32(&running, 0)
+			j.Run()
+		})
+	}
+}
@@ -0,0 +1,291 @@
+package gocron
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Job is anything that can be run on a Schedule.
+type Job interface {
+	Run()
+}
+
+// FuncJob is a Job implemented by a plain function.
+type FuncJob func()
+
+// Run calls f.
+func (f FuncJob) Run() { f() }
+
+// EntryID identifies an Entry within a Cron, returned by AddFunc/AddJob/Schedule
+// and accepted by Remove.
+type EntryID int
+
+// Entry consists of a Schedule and the Job to run on that Schedule.
+type Entry struct {
+	// ID is the cron-assigned ID of this entry, which may be used to look up
+	// or remove it later.
+	ID EntryID
+
+	// Schedule governs when this entry is run.
+	Schedule Schedule
+
+	// Next is the next time this entry will run, or the zero time if Cron
+	// hasn't determined this yet (e.g. because this Entry is being added).
+	Next time.Time
+
+	// Prev is the last time this entry was run, or the zero time if it has
+	// never been run.
+	Prev time.Time
+
+	// Job is the thing that will be run.
+	Job Job
+
+	// WrappedJob is the Job wrapped with all of the Cron's JobWrappers, and
+	// is what's actually invoked.
+	WrappedJob Job
+}
+
+// Valid reports whether this is not the zero Entry.
+func (e Entry) Valid() bool { return e.ID != 0 }
+
+// byTime sorts entries by soonest next activation, moving zero times (not
+// yet scheduled) to the end.
+type byTime []*Entry
+
+func (s byTime) Len() int      { return len(s) }
+func (s byTime) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s byTime) Less(i, j int) bool {
+	if s[i].Next.IsZero() {
+		return false
+	}
+	if s[j].Next.IsZero() {
+		return true
+	}
+	return s[i].Next.Before(s[j].Next)
+}
+
+// Cron runs Jobs on a schedule, per entry, in their own goroutines.
+type Cron struct {
+	entries      []*Entry
+	chain        Chain
+	running      bool
+	runningMu    sync.Mutex
+	logger       Logger
+	location     *time.Location
+	parser       Parser
+	nextID       EntryID
+	jobWaitGroup sync.WaitGroup
+
+	add      chan *Entry
+	remove   chan EntryID
+	snapshot chan chan []Entry
+	stop     chan struct{}
+}
+
+// New returns a Cron ready to have jobs added via AddFunc/AddJob, configured
+// by the given options.
+func New(opts ...Option) *Cron {
+	c := &Cron{
+		chain:    NewChain(),
+		logger:   DiscardLogger,
+		location: time.Local,
+		parser:   standardParser,
+		add:      make(chan *Entry),
+		remove:   make(chan EntryID),
+		snapshot: make(chan chan []Entry),
+		stop:     make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// AddFunc registers cmd to run on the given spec, parsed with this Cron's
+// Parser.
+func (c *Cron) AddFunc(spec string, cmd func()) (EntryID, error) {
+	return c.AddJob(spec, FuncJob(cmd))
+}
+
+// AddJob registers job to run on the given spec, parsed with this Cron's
+// Parser.
+func (c *Cron) AddJob(spec string, job Job) (EntryID, error) {
+	schedule, err := c.parser.Parse(spec)
+	if err != nil {
+		return 0, err
+	}
+	return c.Schedule(schedule, job), nil
+}
+
+// Schedule registers job to run on the given Schedule, bypassing the Parser
+// entirely.
+func (c *Cron) Schedule(schedule Schedule, job Job) EntryID {
+	c.runningMu.Lock()
+	defer c.runningMu.Unlock()
+
+	c.nextID++
+	entry := &Entry{
+		ID:         c.nextID,
+		Schedule:   schedule,
+		Job:        job,
+		WrappedJob: c.chain.Then(job),
+	}
+	if !c.running {
+		c.entries = append(c.entries, entry)
+	} else {
+		c.add <- entry
+	}
+	return entry.ID
+}
+
+// Entries returns a snapshot of the entries currently registered, sorted by
+// nothing in particular; check Entry.Next to find the soonest.
+func (c *Cron) Entries() []Entry {
+	c.runningMu.Lock()
+	defer c.runningMu.Unlock()
+
+	if !c.running {
+		return c.entrySnapshot()
+	}
+	reply := make(chan []Entry, 1)
+	c.snapshot <- reply
+	return <-reply
+}
+
+// Remove unschedules the entry with the given ID, if any.
+func (c *Cron) Remove(id EntryID) {
+	c.runningMu.Lock()
+	defer c.runningMu.Unlock()
+
+	if !c.running {
+		c.removeEntry(id)
+	} else {
+		c.remove <- id
+	}
+}
+
+// Start starts the Cron scheduler in its own goroutine, and returns
+// immediately.
+func (c *Cron) Start() {
+	c.runningMu.Lock()
+	defer c.runningMu.Unlock()
+
+	if c.running {
+		return
+	}
+	c.running = true
+	go c.run()
+}
+
+// Stop halts the Cron scheduler, preventing any further jobs from being
+// started, and returns a context that is cancelled once all running jobs
+// have completed.
+func (c *Cron) Stop() context.Context {
+	c.runningMu.Lock()
+	defer c.runningMu.Unlock()
+
+	if c.running {
+		c.stop <- struct{}{}
+		c.running = false
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		c.jobWaitGroup.Wait()
+		cancel()
+	}()
+	return ctx
+}
+
+// run is the Cron scheduler's main loop. It sleeps until the soonest entry
+// is due, runs whatever's due, and repeats.
+func (c *Cron) run() {
+	now := c.now()
+	for _, entry := range c.entries {
+		entry.Next = entry.Schedule.Next(now)
+	}
+
+	for {
+		sort.Sort(byTime(c.entries))
+
+		var timer *time.Timer
+		if len(c.entries) == 0 || c.entries[0].Next.IsZero() {
+			// No entries, or no entry due: sleep until woken by add/remove/stop.
+			timer = time.NewTimer(100000 * time.Hour)
+		} else {
+			timer = time.NewTimer(c.entries[0].Next.Sub(now))
+		}
+
+		select {
+		case now = <-timer.C:
+			now = now.In(c.location)
+			for _, entry := range c.entries {
+				if entry.Next.After(now) || entry.Next.IsZero() {
+					break
+				}
+				c.startJob(entry.WrappedJob)
+				entry.Prev = entry.Next
+				entry.Next = entry.Schedule.Next(now)
+			}
+			c.logger.Printf("woke at %v", now)
+
+		case entry := <-c.add:
+			timer.Stop()
+			now = c.now()
+			entry.Next = entry.Schedule.Next(now)
+			c.entries = append(c.entries, entry)
+			c.logger.Printf("added entry %d, next run at %v", entry.ID, entry.Next)
+
+		case id := <-c.remove:
+			timer.Stop()
+			now = c.now()
+			c.removeEntry(id)
+			c.logger.Printf("removed entry %d", id)
+
+		case reply := <-c.snapshot:
+			timer.Stop()
+			now = c.now()
+			reply <- c.entrySnapshot()
+			continue
+
+		case <-c.stop:
+			timer.Stop()
+			c.logger.Printf("stopped")
+			return
+		}
+	}
+}
+
+// startJob runs job in its own goroutine, tracked by the wait group Stop
+// waits on before its returned context is cancelled.
+func (c *Cron) startJob(job Job) {
+	c.jobWaitGroup.Add(1)
+	go func() {
+		defer c.jobWaitGroup.Done()
+		job.Run()
+	}()
+}
+
+func (c *Cron) now() time.Time {
+	return time.Now().In(c.location)
+}
+
+func (c *Cron) entrySnapshot() []Entry {
+	entries := make([]Entry, len(c.entries))
+	for i, e := range c.entries {
+		entries[i] = *e
+	}
+	return entries
+}
+
+func (c *Cron) removeEntry(id EntryID) {
+	var entries []*Entry
+	for _, e := range c.entries {
+		if e.ID != id {
+			entries = append(entries, e)
+		}
+	}
+	c.entries = entries
+}
@@ -0,0 +1,134 @@
+package gocron
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fixedIntervalSchedule fires every d, for tests that need faster ticks than
+// everySchedule's 1-second floor allows.
+type fixedIntervalSchedule struct{ d time.Duration }
+
+func (s fixedIntervalSchedule) Next(t time.Time) time.Time { return t.Add(s.d) }
+func (s fixedIntervalSchedule) Prev(t time.Time) time.Time { return t.Add(-s.d) }
+
+func TestCronAddFuncAndRun(t *testing.T) {
+	c := New()
+	var runs int32
+	c.Schedule(fixedIntervalSchedule{10 * time.Millisecond}, FuncJob(func() { atomic.AddInt32(&runs, 1) }))
+
+	c.Start()
+	time.Sleep(55 * time.Millisecond)
+	<-c.Stop().Done()
+
+	if got := atomic.LoadInt32(&runs); got == 0 {
+		t.Errorf("expected job to have run at least once, ran %d times", got)
+	}
+}
+
+func TestCronRemove(t *testing.T) {
+	c := New()
+	var kept, removed int32
+	c.Schedule(fixedIntervalSchedule{10 * time.Millisecond}, FuncJob(func() { atomic.AddInt32(&kept, 1) }))
+	removeID := c.Schedule(fixedIntervalSchedule{10 * time.Millisecond}, FuncJob(func() { atomic.AddInt32(&removed, 1) }))
+
+	c.Remove(removeID)
+	c.Start()
+	time.Sleep(55 * time.Millisecond)
+	<-c.Stop().Done()
+
+	if atomic.LoadInt32(&kept) == 0 {
+		t.Error("expected the non-removed job to have run")
+	}
+	if atomic.LoadInt32(&removed) != 0 {
+		t.Error("expected the removed job not to have run")
+	}
+}
+
+func TestCronEntries(t *testing.T) {
+	c := New()
+	id, err := c.AddFunc("@every 1h", func() {})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	entries := c.Entries()
+	if len(entries) != 1 || entries[0].ID != id {
+		t.Fatalf("expected a single entry with ID %d, got %v", id, entries)
+	}
+
+	c.Start()
+	defer c.Stop()
+	entries = c.Entries()
+	if len(entries) != 1 || entries[0].ID != id || entries[0].Next.IsZero() {
+		t.Fatalf("expected a scheduled entry with ID %d, got %v", id, entries)
+	}
+}
+
+func TestChainRecoverFromPanic(t *testing.T) {
+	job := Recover(DiscardLogger)(FuncJob(func() { panic("boom") }))
+	job.Run() // should not panic
+}
+
+func TestChainSkipIfStillRunning(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var skipped int32
+
+	wrapped := SkipIfStillRunning(DiscardLogger)(FuncJob(func() {
+		started <- struct{}{}
+		<-release
+	}))
+
+	go wrapped.Run()
+	<-started
+
+	// This run should be skipped, since the first is still blocked on release.
+	done := make(chan struct{})
+	go func() {
+		wrapped.Run()
+		atomic.AddInt32(&skipped, 1)
+		close(done)
+	}()
+	<-done
+	close(release)
+
+	if atomic.LoadInt32(&skipped) != 1 {
+		t.Error("expected the overlapping run to return immediately")
+	}
+}
+
+func TestChainDelayIfStillRunning(t *testing.T) {
+	var order []int
+	finished := make(chan struct{})
+
+	wrapped := DelayIfStillRunning(DiscardLogger)(FuncJob(func() {
+		time.Sleep(10 * time.Millisecond)
+		order = append(order, len(order))
+		if len(order) == 2 {
+			close(finished)
+		}
+	}))
+
+	go wrapped.Run()
+	time.Sleep(1 * time.Millisecond) // ensure the first run has taken the lock
+	go wrapped.Run()
+
+	select {
+	case <-finished:
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for both runs to finish")
+	}
+	if len(order) != 2 {
+		t.Errorf("expected both runs to complete, got %d", len(order))
+	}
+}
+
+func TestAddJobParseError(t *testing.T) {
+	c := New()
+	_, err := c.AddJob("not a valid spec", FuncJob(func() {}))
+	if err == nil {
+		t.Fatal("expected an error for an invalid spec")
+	}
+}
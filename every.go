@@ -25,3 +25,11 @@ func every(duration time.Duration) everySchedule {
 func (s everySchedule) Next(t time.Time) time.Time {
 	return t.Add(s.delay - time.Duration(t.Nanosecond())*time.Nanosecond)
 }
+
+// Prev returns the most recent time this should have been run: t, minus one
+// delay, with the same sub-second rounding Next applies. Since delay always
+// divides evenly into whole seconds, Prev(Next(t)) rounds back to t with any
+// sub-second component dropped.
+func (s everySchedule) Prev(t time.Time) time.Time {
+	return t.Add(-s.delay - time.Duration(t.Nanosecond())*time.Nanosecond)
+}
@@ -0,0 +1,104 @@
+package gocron
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseWithSeed parses a standard 6-field spec the same as Parse, except any
+// "H" token in a field is replaced with a value deterministically derived by
+// hashing seed, so that the same spec can be shared across a fleet of jobs
+// while spreading their actual activation times across each field's range
+// (Jenkins calls this syntax "hashed time"). "H(a-b)" restricts the hashed
+// value to the inclusive subrange [a, b] of that field, e.g. "H(0-29)" for a
+// minute field picks some stable minute in the first half of the hour.
+//
+// The same seed always resolves to the same concrete schedule; different
+// seeds (e.g. different job names) spread across the field's range.
+func ParseWithSeed(spec string, seed string, loc *time.Location) (Schedule, error) {
+	fields := strings.Fields(spec)
+	if count := len(fields); count != 6 {
+		return nil, fmt.Errorf("expected exactly %d fields, found %d: %s", 6, count, fields)
+	}
+
+	fieldBounds := []bounds{seconds, minutes, hours, doms, months, dows}
+	fieldNames := []string{"second", "minute", "hour", "dom", "month", "dow"}
+
+	resolved := make([]string, len(fields))
+	for i, field := range fields {
+		r, err := resolveHashedField(field, fieldBounds[i], fnv64a(seed, fieldNames[i]))
+		if err != nil {
+			return nil, err
+		}
+		resolved[i] = r
+	}
+
+	return ParseWithLocation(strings.Join(resolved, " "), loc)
+}
+
+// fnv64a returns the FNV-1a hash of seed mixed with field, used to
+// deterministically resolve "H" tokens to concrete field values. Mixing in
+// the field name decorrelates fields that share the same bounds (e.g. second
+// and minute, both 0-59) so a fleet of jobs spreads across each field
+// independently, rather than landing on the same number in every field.
+func fnv64a(seed string, field string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(seed))
+	h.Write([]byte{0})
+	h.Write([]byte(field))
+	return h.Sum64()
+}
+
+// resolveHashedField replaces any "H" or "H(a-b)" tokens in field with a
+// concrete number derived from hash modulo the field's range (or the given
+// subrange of it). Every other comma-separated token is passed through
+// unchanged, ahead of the existing getBits machinery.
+func resolveHashedField(field string, r bounds, hash uint64) (string, error) {
+	parts := strings.FieldsFunc(field, func(c rune) bool { return c == ',' })
+	resolved := make([]string, len(parts))
+
+	for i, part := range parts {
+		switch {
+		case part == "H":
+			resolved[i] = strconv.FormatUint(uint64(hashInRange(hash, r.min, r.max)), 10)
+
+		case strings.HasPrefix(part, "H(") && strings.HasSuffix(part, ")"):
+			low, high, err := parseHRange(part)
+			if err != nil {
+				return "", err
+			}
+			if low < r.min || high > r.max || low > high {
+				return "", fmt.Errorf("H(a-b) subrange out of bounds: %s", part)
+			}
+			resolved[i] = strconv.FormatUint(uint64(hashInRange(hash, low, high)), 10)
+
+		default:
+			resolved[i] = part
+		}
+	}
+	return strings.Join(resolved, ","), nil
+}
+
+// parseHRange parses the "a-b" inside an "H(a-b)" token.
+func parseHRange(part string) (low, high uint, err error) {
+	inner := part[len("H(") : len(part)-1]
+	lowAndHigh := strings.SplitN(inner, "-", 2)
+	if len(lowAndHigh) != 2 {
+		return 0, 0, fmt.Errorf("malformed H(a-b) expression: %s", part)
+	}
+	if low, err = mustParseInt(lowAndHigh[0]); err != nil {
+		return 0, 0, fmt.Errorf("invalid H(a-b) expression %s: %s", part, err)
+	}
+	if high, err = mustParseInt(lowAndHigh[1]); err != nil {
+		return 0, 0, fmt.Errorf("invalid H(a-b) expression %s: %s", part, err)
+	}
+	return low, high, nil
+}
+
+// hashInRange deterministically maps hash into [min, max].
+func hashInRange(hash uint64, min, max uint) uint {
+	return min + uint(hash%uint64(max-min+1))
+}
@@ -0,0 +1,79 @@
+package gocron
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseWithSeedDeterministic(t *testing.T) {
+	a, err := ParseWithSeed("H H * * * *", "my-job", time.UTC)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	b, err := ParseWithSeed("H H * * * *", "my-job", time.UTC)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(a, b) {
+		t.Errorf("expected the same seed to resolve to the same schedule, got %v and %v", a, b)
+	}
+}
+
+func TestParseWithSeedSpreadsDifferentSeeds(t *testing.T) {
+	seen := map[time.Time]bool{}
+	for _, seed := range []string{"job-a", "job-b", "job-c", "job-d", "job-e"} {
+		s, err := ParseWithSeed("H H * * * *", seed, time.UTC)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		next := s.Next(time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC))
+		seen[time.Date(0, 1, 1, next.Hour(), next.Minute(), next.Second(), 0, time.UTC)] = true
+	}
+	if len(seen) < 2 {
+		t.Errorf("expected different seeds to spread across distinct times, got %d distinct time(s)", len(seen))
+	}
+}
+
+func TestParseWithSeedDecorrelatesFieldsWithEqualBounds(t *testing.T) {
+	// second and minute share the same 0-59 bounds; mixing the field name
+	// into the hash should keep them from landing on the same number.
+	s, err := ParseWithSeed("H H * * * *", "my-job", time.UTC)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	next := s.Next(time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC))
+	if next.Second() == next.Minute() {
+		t.Errorf("expected second and minute to be resolved independently, both got %d", next.Second())
+	}
+}
+
+func TestParseWithSeedRange(t *testing.T) {
+	s, err := ParseWithSeed("H H(0-29) * * * *", "my-job", time.UTC)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	next := s.Next(time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC))
+	if next.Minute() > 29 {
+		t.Errorf("expected minute to be constrained to [0, 29], got %d", next.Minute())
+	}
+}
+
+func TestParseWithSeedErrors(t *testing.T) {
+	entries := []struct {
+		spec, err string
+	}{
+		{"H H * *", "expected exactly 6 fields"},
+		{"H(0-99) * * * * *", "out of bounds"},
+		{"H(x-5) * * * * *", "invalid H(a-b) expression"},
+		{"H(5) * * * * *", "malformed H(a-b) expression"},
+	}
+
+	for _, c := range entries {
+		_, err := ParseWithSeed(c.spec, "my-job", time.UTC)
+		if err == nil || !strings.Contains(err.Error(), c.err) {
+			t.Errorf("%s => expected error %q, got %v", c.spec, c.err, err)
+		}
+	}
+}
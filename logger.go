@@ -0,0 +1,23 @@
+package gocron
+
+import (
+	"log"
+	"os"
+)
+
+// Logger is the logging interface used by Cron. It is satisfied by
+// *log.Logger, so callers can pass one in directly.
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+// DefaultLogger logs to os.Stderr, prefixed with "gocron: ".
+var DefaultLogger Logger = log.New(os.Stderr, "gocron: ", log.LstdFlags)
+
+// discardLogger drops everything it's given; used when no logger is configured.
+type discardLogger struct{}
+
+func (discardLogger) Printf(format string, v ...interface{}) {}
+
+// DiscardLogger silently discards all log output.
+var DiscardLogger Logger = discardLogger{}
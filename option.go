@@ -0,0 +1,38 @@
+package gocron
+
+import "time"
+
+// Option configures a Cron during New.
+type Option func(*Cron)
+
+// WithLocation sets the time zone newly scheduled entries are evaluated in.
+func WithLocation(loc *time.Location) Option {
+	return func(c *Cron) {
+		c.location = loc
+	}
+}
+
+// WithParser sets the Parser used by AddFunc/AddJob to turn spec strings
+// into Schedules. The default is the standard 6-field Parser used by Parse.
+func WithParser(p Parser) Option {
+	return func(c *Cron) {
+		c.parser = p
+	}
+}
+
+// WithLogger sets the Logger used to report the scheduler's internal events
+// (added/removed entries, wake-ups, stopping). JobWrappers such as Recover
+// log to the Logger passed to them, not to this one.
+func WithLogger(logger Logger) Option {
+	return func(c *Cron) {
+		c.logger = logger
+	}
+}
+
+// WithChain sets the JobWrappers applied to every Job added via AddFunc or
+// AddJob, in the order given.
+func WithChain(wrappers ...JobWrapper) Option {
+	return func(c *Cron) {
+		c.chain = NewChain(wrappers...)
+	}
+}
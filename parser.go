@@ -8,30 +8,106 @@ import (
 	"time"
 )
 
+// ParseOption is a configuration flag accepted by NewParser to control which
+// fields a Parser requires, whether a field is optional, and whether
+// descriptors (such as "@hourly") are permitted.
+type ParseOption int
+
+const (
+	Second      ParseOption = 1 << iota // Seconds field, default 0
+	Minute                              // Minutes field, default 0
+	Hour                                // Hours field, default 0
+	Dom                                 // Day of month field, default *
+	Month                               // Month field, default *
+	Dow                                 // Day of week field, default *
+	DowOptional                         // Day of week field, optional, default *
+	Descriptor                          // Allow descriptors such as @monthly, @weekly, etc.
+)
+
+// places lists the six cron fields in spec order, paired with their
+// ParseOption bit and default value.
+var places = []ParseOption{
+	Second,
+	Minute,
+	Hour,
+	Dom,
+	Month,
+	Dow,
+}
+
+// defaults holds the value substituted for a field that a Parser does not
+// require. Time fields ("0") default to the zeroth tick; date fields ("*")
+// default to "every value".
+var defaults = []string{
+	"0",
+	"0",
+	"0",
+	"*",
+	"*",
+	"*",
+}
+
+// Parser is a configurable cron spec parser. Use NewParser to build one that
+// requires a particular subset of the six fields, then call Parse or
+// ParseWithLocation to turn a spec string into a Schedule.
+type Parser struct {
+	options ParseOption
+}
+
+// NewParser returns a Parser configured by the given options. Fields not
+// included in options are filled in with their defaults when parsing
+// ("0" for Second/Minute/Hour, "*" for Dom/Month/Dow). DowOptional implies
+// Dow, but permits the field to be omitted from the spec string.
+func NewParser(options ParseOption) Parser {
+	if options&DowOptional > 0 {
+		options |= Dow
+	}
+	return Parser{options: options}
+}
+
+// standardParser matches the long-standing behavior of Parse/ParseWithLocation:
+// all six fields are required, and descriptors are permitted.
+var standardParser = NewParser(Second | Minute | Hour | Dom | Month | Dow | Descriptor)
+
 func Parse(spec string) (Schedule, error) {
-	return ParseWithLocation(spec, time.Local)
+	return standardParser.Parse(spec)
 }
 
 func ParseWithLocation(spec string, loc *time.Location) (Schedule, error) {
+	return standardParser.ParseWithLocation(spec, loc)
+}
+
+// Parse turns a spec string into a Schedule, using time.Local for any
+// resulting schedule's location.
+func (p Parser) Parse(spec string) (Schedule, error) {
+	return p.ParseWithLocation(spec, time.Local)
+}
+
+// ParseWithLocation turns a spec string into a Schedule evaluated in loc,
+// according to the fields this Parser requires.
+func (p Parser) ParseWithLocation(spec string, loc *time.Location) (Schedule, error) {
 	// Check if spec is an empty string
 	if len(spec) == 0 {
 		return nil, fmt.Errorf("empty spec string")
 	}
 
-	// Handle descriptors if present
+	// Handle descriptors if present and permitted.
 	if strings.HasPrefix(spec, "@") {
+		if p.options&Descriptor == 0 {
+			return nil, fmt.Errorf("parser does not accept descriptors: %s", spec)
+		}
 		return parseDescriptor(spec, loc)
 	}
 
 	// Split on whitespace.
 	fields := strings.Fields(spec)
 
-	// Validate number of fields
-	if count := len(fields); count != 6 {
-		return nil, fmt.Errorf("expected exactly %d fields, found %d: %s", 6, count, fields)
+	// Validate field count and fill in any fields this Parser doesn't require.
+	fields, err := normalizeFields(fields, p.options)
+	if err != nil {
+		return nil, err
 	}
 
-	var err error
 	field := func(field string, r bounds) uint64 {
 		if err != nil {
 			return 0
@@ -45,25 +121,80 @@ func ParseWithLocation(spec string, loc *time.Location) (Schedule, error) {
 		second = field(fields[0], seconds)
 		minute = field(fields[1], minutes)
 		hour   = field(fields[2], hours)
-		dom    = field(fields[3], doms)
 		month  = field(fields[4], months)
-		dow    = field(fields[5], dows)
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	dom, domLast, domLastOffset, domNearestWeekday, err := parseDomField(fields[3])
+	if err != nil {
+		return nil, err
+	}
+	dow, dowNth, dowLastMask, err := parseDowField(fields[5])
+	if err != nil {
+		return nil, err
+	}
+
 	return &specSchedule{
-		second:   second,
-		minute:   minute,
-		hour:     hour,
-		dom:      dom,
-		month:    month,
-		dow:      dow,
-		location: loc,
+		second:            second,
+		minute:            minute,
+		hour:              hour,
+		dom:               dom,
+		month:             month,
+		dow:               dow,
+		domLast:           domLast,
+		domLastOffset:     domLastOffset,
+		domNearestWeekday: domNearestWeekday,
+		dowNth:            dowNth,
+		dowLastMask:       dowLastMask,
+		location:          loc,
 	}, nil
 }
 
+// normalizeFields validates the number of fields found against what options
+// requires and returns a 6-element slice (second, minute, hour, dom, month,
+// dow) with any non-required fields filled in from defaults.
+func normalizeFields(fields []string, options ParseOption) ([]string, error) {
+	max := 0
+	for _, place := range places {
+		if options&place > 0 {
+			max++
+		}
+	}
+	min := max
+	if options&DowOptional > 0 {
+		min--
+	}
+
+	if count := len(fields); count < min || count > max {
+		if min == max {
+			return nil, fmt.Errorf("expected exactly %d fields, found %d: %s", min, count, fields)
+		}
+		return nil, fmt.Errorf("expected %d to %d fields, found %d: %s", min, max, count, fields)
+	}
+
+	// If the optional field was omitted, fill it in with its default so the
+	// positional fill-in below lines up.
+	if min < max && len(fields) == min {
+		if options&DowOptional == 0 {
+			return nil, fmt.Errorf("unknown optional field")
+		}
+		fields = append(fields, defaults[5])
+	}
+
+	n := 0
+	expandedFields := make([]string, len(places))
+	copy(expandedFields, defaults)
+	for i, place := range places {
+		if options&place > 0 {
+			expandedFields[i] = fields[n]
+			n++
+		}
+	}
+	return expandedFields, nil
+}
+
 // getField returns an Int with the bits set to represent all of the times that
 // the field represents or error parsing field value.  A "field" is a comma-separated
 // list of "ranges".
@@ -185,8 +185,16 @@ func TestStandardSpecSchedule(t *testing.T) {
 		err      string
 	}{
 		{
-			expr:     "0 5 * * * *",
-			expected: &specSchedule{1 << seconds.min, 1 << 5, allBits(hours), allBits(doms), allBits(months), allBits(dows), time.Local},
+			expr: "0 5 * * * *",
+			expected: &specSchedule{
+				second:   1 << seconds.min,
+				minute:   1 << 5,
+				hour:     allBits(hours),
+				dom:      allBits(doms),
+				month:    allBits(months),
+				dow:      allBits(dows),
+				location: time.Local,
+			},
 		},
 		{
 			expr:     "@every 5m",
@@ -216,12 +224,144 @@ func TestStandardSpecSchedule(t *testing.T) {
 	}
 }
 
+func TestNewParserStandardFiveField(t *testing.T) {
+	p := NewParser(Minute | Hour | Dom | Month | Dow)
+
+	actual, err := p.Parse("0 15 */3 * *")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := &specSchedule{
+		second:   1 << seconds.min,
+		minute:   1 << 0,
+		hour:     1 << 15,
+		dom:      getBits(1, 31, 3),
+		month:    allBits(months),
+		dow:      allBits(dows),
+		location: time.Local,
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+}
+
+func TestNewParserFieldCombinations(t *testing.T) {
+	entries := []struct {
+		options  ParseOption
+		spec     string
+		expected Schedule
+		err      string
+	}{
+		{
+			options: Minute | Hour | Dom | Month | Dow,
+			spec:    "15 * * * *",
+			expected: &specSchedule{
+				second:   1 << seconds.min,
+				minute:   1 << 15,
+				hour:     allBits(hours),
+				dom:      allBits(doms),
+				month:    allBits(months),
+				dow:      allBits(dows),
+				location: time.Local,
+			},
+		},
+		{
+			options: Minute | Hour | Dom | Month | Dow | DowOptional,
+			spec:    "15 * * *",
+			expected: &specSchedule{
+				second:   1 << seconds.min,
+				minute:   1 << 15,
+				hour:     allBits(hours),
+				dom:      allBits(doms),
+				month:    allBits(months),
+				dow:      allBits(dows),
+				location: time.Local,
+			},
+		},
+		{
+			options: Minute | Hour | Dom | Month | Dow | DowOptional,
+			spec:    "15 * * * 1",
+			expected: &specSchedule{
+				second:   1 << seconds.min,
+				minute:   1 << 15,
+				hour:     allBits(hours),
+				dom:      allBits(doms),
+				month:    allBits(months),
+				dow:      1 << 1,
+				location: time.Local,
+			},
+		},
+		{
+			options: Second | Minute | Hour,
+			spec:    "30 15 9",
+			expected: &specSchedule{
+				second:   1 << 30,
+				minute:   1 << 15,
+				hour:     1 << 9,
+				dom:      allBits(doms),
+				month:    allBits(months),
+				dow:      allBits(dows),
+				location: time.Local,
+			},
+		},
+		{
+			options: Minute | Hour | Dom | Month | Dow,
+			spec:    "15 * * *",
+			err:     "expected exactly 5 fields, found 4",
+		},
+		{
+			options: Minute | Hour | Dom | Month | Dow | DowOptional,
+			spec:    "15 * *",
+			err:     "expected 4 to 5 fields, found 3",
+		},
+		{
+			options: Second | Minute | Hour | Dom | Month | Dow,
+			spec:    "@hourly",
+			err:     "parser does not accept descriptors",
+		},
+	}
+
+	for _, c := range entries {
+		p := NewParser(c.options)
+		actual, err := p.Parse(c.spec)
+		if len(c.err) != 0 {
+			if err == nil || !strings.Contains(err.Error(), c.err) {
+				t.Errorf("%s => expected error %q, got %v", c.spec, c.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s => unexpected error %v", c.spec, err)
+			continue
+		}
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("%s => expected %v, got %v", c.spec, c.expected, actual)
+		}
+	}
+}
+
 func every5min(loc *time.Location) *specSchedule {
-	return &specSchedule{1 << 0, 1 << 5, allBits(hours), allBits(doms), allBits(months), allBits(dows), loc}
+	return &specSchedule{
+		second:   1 << 0,
+		minute:   1 << 5,
+		hour:     allBits(hours),
+		dom:      allBits(doms),
+		month:    allBits(months),
+		dow:      allBits(dows),
+		location: loc,
+	}
 }
 
 func midnight(loc *time.Location) *specSchedule {
-	return &specSchedule{1, 1, 1, allBits(doms), allBits(months), allBits(dows), loc}
+	return &specSchedule{
+		second:   1,
+		minute:   1,
+		hour:     1,
+		dom:      allBits(doms),
+		month:    allBits(months),
+		dow:      allBits(dows),
+		location: loc,
+	}
 }
 
 func annual(loc *time.Location) *specSchedule {
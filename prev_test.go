@@ -0,0 +1,119 @@
+package gocron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEverySchedulePrev(t *testing.T) {
+	s := every(5 * time.Minute)
+	from := time.Date(2026, time.July, 1, 10, 7, 30, 0, time.UTC)
+	expected := time.Date(2026, time.July, 1, 10, 2, 30, 0, time.UTC)
+	if actual := s.Prev(from); !actual.Equal(expected) {
+		t.Errorf("Prev(%s) => expected %s, got %s", from, expected, actual)
+	}
+}
+
+func TestEverySchedulePrevNextReversible(t *testing.T) {
+	s := every(5 * time.Minute)
+	from := time.Date(2026, time.July, 1, 10, 7, 30, 0, time.UTC)
+	if actual := s.Prev(s.Next(from)); !actual.Equal(from) {
+		t.Errorf("Prev(Next(%s)) => expected %s, got %s", from, from, actual)
+	}
+}
+
+func TestSpecSchedulePrev(t *testing.T) {
+	s, err := Parse("30 15 9 1 * *")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	entries := []struct {
+		from, expected time.Time
+	}{
+		// Later in the same month: previous activation was the 1st of this month.
+		{time.Date(2026, time.July, 15, 0, 0, 0, 0, time.UTC), time.Date(2026, time.July, 1, 9, 15, 30, 0, time.UTC)},
+		// Exactly on an activation: Prev is strictly before it.
+		{time.Date(2026, time.July, 1, 9, 15, 30, 0, time.UTC), time.Date(2026, time.June, 1, 9, 15, 30, 0, time.UTC)},
+		// Crossing a year boundary.
+		{time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, time.December, 1, 9, 15, 30, 0, time.UTC)},
+	}
+
+	for _, c := range entries {
+		actual := s.Prev(c.from)
+		if !actual.Equal(c.expected) {
+			t.Errorf("Prev(%s) => expected %s, got %s", c.from, c.expected, actual)
+		}
+	}
+}
+
+func TestSpecSchedulePrevNoMatchWithinBound(t *testing.T) {
+	// February 30th never exists, so this schedule never activates.
+	s, err := Parse("0 0 0 30 2 *")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if actual := s.Prev(time.Date(2026, time.July, 1, 0, 0, 0, 0, time.UTC)); !actual.IsZero() {
+		t.Errorf("expected zero time, got %s", actual)
+	}
+}
+
+func TestSpecScheduleNextPrevReversibleAcrossDST(t *testing.T) {
+	nyc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("tzdata unavailable: %v", err)
+	}
+
+	s, err := ParseWithLocation("0 30 2 * * *", nyc)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Spans the US spring-forward (2026-03-08) and fall-back (2026-11-01)
+	// transitions.
+	froms := []time.Time{
+		time.Date(2026, time.March, 8, 0, 0, 0, 0, nyc),
+		time.Date(2026, time.November, 1, 0, 0, 0, 0, nyc),
+	}
+
+	for _, from := range froms {
+		next := s.Next(from)
+		if next.IsZero() {
+			t.Fatalf("Next(%s) => zero time", from)
+		}
+		prev := s.Prev(next)
+		if !prev.Before(next) {
+			t.Errorf("Prev(Next(%s))=%s should be strictly before Next=%s", from, prev, next)
+		}
+		if got := s.Next(prev); !got.Equal(next) {
+			t.Errorf("Next(Prev(Next(%s)))=%s, expected %s", from, got, next)
+		}
+	}
+}
+
+func TestWindowBetween(t *testing.T) {
+	s, err := Parse("0 0 0 1 * *")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	w := Window{Schedule: s}
+
+	from := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, time.April, 1, 0, 0, 0, 0, time.UTC)
+	expected := []time.Time{
+		time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, time.February, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, time.April, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	actual := w.Between(from, to)
+	if len(actual) != len(expected) {
+		t.Fatalf("expected %d activations, got %d: %v", len(expected), len(actual), actual)
+	}
+	for i, e := range expected {
+		if !actual[i].Equal(e) {
+			t.Errorf("activation %d: expected %s, got %s", i, e, actual[i])
+		}
+	}
+}
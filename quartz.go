@@ -0,0 +1,187 @@
+package gocron
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// parseDomField parses a day-of-month field, which in addition to the usual
+// comma-separated ranges accepts the Quartz extensions:
+//
+//	L     the last day of the month
+//	L-n   n days before the last day of the month
+//	dW    the weekday nearest day d
+//
+// L and L-n and dW may not be combined with each other or with a list; each
+// must be the sole expression in the field.
+func parseDomField(field string) (bits uint64, last bool, lastOffset int, nearestWeekday int, err error) {
+	parts := strings.FieldsFunc(field, func(r rune) bool { return r == ',' })
+	solo := len(parts) == 1
+
+	for _, part := range parts {
+		switch {
+		case part == "L":
+			if !solo {
+				return 0, false, 0, 0, fmt.Errorf("L cannot be combined with a list: %s", field)
+			}
+			last = true
+
+		case strings.HasPrefix(part, "L-"):
+			if !solo {
+				return 0, false, 0, 0, fmt.Errorf("L-n cannot be combined with a list: %s", field)
+			}
+			n, perr := mustParseInt(part[len("L-"):])
+			if perr != nil {
+				return 0, false, 0, 0, fmt.Errorf("invalid L-n expression %s: %s", part, perr)
+			}
+			last = true
+			lastOffset = int(n)
+
+		case strings.HasSuffix(part, "W"):
+			if !solo {
+				return 0, false, 0, 0, fmt.Errorf("dW cannot be combined with a list: %s", field)
+			}
+			day, perr := mustParseInt(strings.TrimSuffix(part, "W"))
+			if perr != nil {
+				return 0, false, 0, 0, fmt.Errorf("invalid dW expression %s: %s", part, perr)
+			}
+			if day < doms.min || day > doms.max {
+				return 0, false, 0, 0, fmt.Errorf("day %d out of range in %s", day, part)
+			}
+			nearestWeekday = int(day)
+
+		default:
+			bit, rerr := getRange(part, doms)
+			if rerr != nil {
+				return 0, false, 0, 0, rerr
+			}
+			bits |= bit
+		}
+	}
+	return bits, last, lastOffset, nearestWeekday, nil
+}
+
+// parseDowField parses a day-of-week field, which in addition to the usual
+// comma-separated ranges accepts the Quartz extensions:
+//
+//	L     Saturday (same as 6 or SAT: a bare L is just an alias)
+//	dL    the last occurrence of weekday d in the month
+//	d#n   the nth occurrence of weekday d in the month
+//
+// Unlike the dom extensions, these may appear alongside other list entries,
+// matching Quartz's own day-of-week grammar. Repeating d#n for the same
+// weekday with a different n (e.g. "2#1,2#3") accumulates, rather than the
+// later one silently overwriting the earlier: both occurrences are honored.
+func parseDowField(field string) (bits uint64, nth map[uint]uint8, lastMask uint8, err error) {
+	parts := strings.FieldsFunc(field, func(r rune) bool { return r == ',' })
+
+	for _, part := range parts {
+		switch {
+		case part == "L":
+			bits |= 1 << dows.max
+
+		case strings.HasSuffix(part, "L"):
+			day, perr := parseIntOrName(strings.TrimSuffix(part, "L"), dows.names)
+			if perr != nil {
+				return 0, nil, 0, fmt.Errorf("invalid dL expression %s: %s", part, perr)
+			}
+			if day < dows.min || day > dows.max {
+				return 0, nil, 0, fmt.Errorf("day %d out of range in %s", day, part)
+			}
+			lastMask |= 1 << day
+
+		case strings.Contains(part, "#"):
+			pieces := strings.SplitN(part, "#", 2)
+			day, perr := parseIntOrName(pieces[0], dows.names)
+			if perr != nil {
+				return 0, nil, 0, fmt.Errorf("invalid d#n expression %s: %s", part, perr)
+			}
+			if day < dows.min || day > dows.max {
+				return 0, nil, 0, fmt.Errorf("day %d out of range in %s", day, part)
+			}
+			n, perr := mustParseInt(pieces[1])
+			if perr != nil || n < 1 || n > 5 {
+				return 0, nil, 0, fmt.Errorf("occurrence must be 1-5 in %s", part)
+			}
+			if nth == nil {
+				nth = make(map[uint]uint8)
+			}
+			nth[day] |= 1 << n
+
+		default:
+			bit, rerr := getRange(part, dows)
+			if rerr != nil {
+				return 0, nil, 0, rerr
+			}
+			bits |= bit
+		}
+	}
+	return bits, nth, lastMask, nil
+}
+
+// domSpecialMatches reports whether t satisfies the schedule's L, L-n or dW
+// day-of-month constraint, if any was set.
+func domSpecialMatches(s *specSchedule, t time.Time) bool {
+	switch {
+	case s.domLast:
+		return t.Day() == daysInMonth(t.Year(), t.Month())-s.domLastOffset
+	case s.domNearestWeekday > 0:
+		if s.domNearestWeekday > daysInMonth(t.Year(), t.Month()) {
+			return false
+		}
+		return t.Day() == nearestWeekday(t.Year(), t.Month(), s.domNearestWeekday).Day()
+	}
+	return false
+}
+
+// dowSpecialMatches reports whether t satisfies the schedule's dL or d#n
+// day-of-week constraint, if any was set.
+func dowSpecialMatches(s *specSchedule, t time.Time) bool {
+	weekday := uint(t.Weekday())
+	if s.dowLastMask&(1<<weekday) > 0 && isLastWeekdayOfMonth(t) {
+		return true
+	}
+	if mask, ok := s.dowNth[weekday]; ok {
+		return mask&(1<<weekdayOccurrence(t)) > 0
+	}
+	return false
+}
+
+// daysInMonth returns the number of days in the given month.
+func daysInMonth(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// nearestWeekday returns the weekday nearest to the given day of month,
+// following Quartz's rule: a Saturday rolls back to Friday (forward to
+// Monday if day is the 1st), and a Sunday rolls forward to Monday (back to
+// Friday if day is the last day of the month).
+func nearestWeekday(year int, month time.Month, day int) time.Time {
+	d := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	switch d.Weekday() {
+	case time.Saturday:
+		if day == 1 {
+			return d.AddDate(0, 0, 2)
+		}
+		return d.AddDate(0, 0, -1)
+	case time.Sunday:
+		if day == daysInMonth(year, month) {
+			return d.AddDate(0, 0, -2)
+		}
+		return d.AddDate(0, 0, 1)
+	}
+	return d
+}
+
+// isLastWeekdayOfMonth reports whether t falls in the final occurrence of
+// its weekday within its month.
+func isLastWeekdayOfMonth(t time.Time) bool {
+	return t.Day()+7 > daysInMonth(t.Year(), t.Month())
+}
+
+// weekdayOccurrence returns which occurrence (1-5) of its weekday t is
+// within its month, e.g. the third Tuesday returns 3.
+func weekdayOccurrence(t time.Time) uint {
+	return uint((t.Day()-1)/7 + 1)
+}
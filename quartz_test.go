@@ -0,0 +1,233 @@
+package gocron
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseDomField(t *testing.T) {
+	entries := []struct {
+		expr                       string
+		bits                       uint64
+		last                       bool
+		lastOffset, nearestWeekday int
+		err                        string
+	}{
+		{expr: "15", bits: 1 << 15},
+		{expr: "L", last: true},
+		{expr: "L-3", last: true, lastOffset: 3},
+		{expr: "15W", nearestWeekday: 15},
+		{expr: "1,L", err: "cannot be combined with a list"},
+		{expr: "1,L-3", err: "cannot be combined with a list"},
+		{expr: "1,15W", err: "cannot be combined with a list"},
+		{expr: "32W", err: "out of range"},
+		{expr: "L-x", err: "invalid L-n expression"},
+	}
+
+	for _, c := range entries {
+		bits, last, lastOffset, nearestWeekday, err := parseDomField(c.expr)
+		if len(c.err) != 0 {
+			if err == nil || !strings.Contains(err.Error(), c.err) {
+				t.Errorf("%s => expected error %q, got %v", c.expr, c.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s => unexpected error %v", c.expr, err)
+			continue
+		}
+		if bits != c.bits || last != c.last || lastOffset != c.lastOffset || nearestWeekday != c.nearestWeekday {
+			t.Errorf("%s => expected (%d, %v, %d, %d), got (%d, %v, %d, %d)",
+				c.expr, c.bits, c.last, c.lastOffset, c.nearestWeekday, bits, last, lastOffset, nearestWeekday)
+		}
+	}
+}
+
+func TestParseDowField(t *testing.T) {
+	entries := []struct {
+		expr     string
+		bits     uint64
+		nth      map[uint]uint8
+		lastMask uint8
+		err      string
+	}{
+		{expr: "1", bits: 1 << 1},
+		{expr: "L", bits: 1 << 6},
+		{expr: "FRIL", lastMask: 1 << 5},
+		{expr: "2#1", nth: map[uint]uint8{2: 1 << 1}},
+		{expr: "MON#1,FRI#2", nth: map[uint]uint8{1: 1 << 1, 5: 1 << 2}},
+		{expr: "2#1,2#3", nth: map[uint]uint8{2: 1<<1 | 1<<3}},
+		{expr: "8L", err: "out of range"},
+		{expr: "2#6", err: "occurrence must be 1-5"},
+	}
+
+	for _, c := range entries {
+		bits, nth, lastMask, err := parseDowField(c.expr)
+		if len(c.err) != 0 {
+			if err == nil || !strings.Contains(err.Error(), c.err) {
+				t.Errorf("%s => expected error %q, got %v", c.expr, c.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s => unexpected error %v", c.expr, err)
+			continue
+		}
+		if bits != c.bits || lastMask != c.lastMask {
+			t.Errorf("%s => expected (%d, %d), got (%d, %d)", c.expr, c.bits, c.lastMask, bits, lastMask)
+		}
+		if len(c.nth) != len(nth) {
+			t.Errorf("%s => expected nth %v, got %v", c.expr, c.nth, nth)
+			continue
+		}
+		for day, n := range c.nth {
+			if nth[day] != n {
+				t.Errorf("%s => expected nth[%d]=%d, got %d", c.expr, day, n, nth[day])
+			}
+		}
+	}
+}
+
+func TestSpecScheduleLastDayOfMonth(t *testing.T) {
+	s, err := Parse("0 0 0 L * *")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	entries := []struct {
+		from, expected time.Time
+	}{
+		// February in a non-leap year.
+		{time.Date(2026, time.February, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, time.February, 28, 0, 0, 0, 0, time.UTC)},
+		// February in a leap year.
+		{time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		// 30-day month.
+		{time.Date(2026, time.April, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, time.April, 30, 0, 0, 0, 0, time.UTC)},
+		// 31-day month.
+		{time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, time.January, 31, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, c := range entries {
+		actual := s.Next(c.from)
+		if !actual.Equal(c.expected) {
+			t.Errorf("Next(%s) => expected %s, got %s", c.from, c.expected, actual)
+		}
+	}
+}
+
+func TestSpecScheduleLastMinusNDayOfMonth(t *testing.T) {
+	s, err := Parse("0 0 0 L-3 * *")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	from := time.Date(2026, time.February, 1, 0, 0, 0, 0, time.UTC)
+	expected := time.Date(2026, time.February, 25, 0, 0, 0, 0, time.UTC)
+	if actual := s.Next(from); !actual.Equal(expected) {
+		t.Errorf("Next(%s) => expected %s, got %s", from, expected, actual)
+	}
+}
+
+func TestSpecScheduleNearestWeekday(t *testing.T) {
+	s, err := Parse("0 0 0 15W * *")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	entries := []struct {
+		from, expected time.Time
+	}{
+		// 2026-08-15 is a Saturday; nearest weekday rolls back to Friday.
+		{time.Date(2026, time.August, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, time.August, 14, 0, 0, 0, 0, time.UTC)},
+		// 2026-11-15 is a Sunday; nearest weekday rolls forward to Monday.
+		{time.Date(2026, time.November, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, time.November, 16, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, c := range entries {
+		actual := s.Next(c.from)
+		if !actual.Equal(c.expected) {
+			t.Errorf("Next(%s) => expected %s, got %s", c.from, c.expected, actual)
+		}
+	}
+}
+
+func TestSpecScheduleNearestWeekdayOutOfRangeMonth(t *testing.T) {
+	// Day 30 doesn't exist in February, so the schedule must skip it
+	// entirely rather than matching whatever time.Date normalizes it to.
+	s, err := Parse("0 0 0 30W * *")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	from := time.Date(2026, time.February, 1, 0, 0, 0, 0, time.UTC)
+	expected := time.Date(2026, time.March, 30, 0, 0, 0, 0, time.UTC)
+	if actual := s.Next(from); !actual.Equal(expected) {
+		t.Errorf("Next(%s) => expected %s, got %s", from, expected, actual)
+	}
+}
+
+func TestSpecScheduleNthWeekday(t *testing.T) {
+	// The third Tuesday of every month.
+	s, err := Parse("0 0 0 * * 2#3")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	from := time.Date(2026, time.July, 1, 0, 0, 0, 0, time.UTC)
+	expected := time.Date(2026, time.July, 21, 0, 0, 0, 0, time.UTC)
+	if actual := s.Next(from); !actual.Equal(expected) {
+		t.Errorf("Next(%s) => expected %s, got %s", from, expected, actual)
+	}
+}
+
+func TestSpecScheduleDowBareL(t *testing.T) {
+	// A bare L in the dow field means Saturday, every Saturday - not just
+	// the last one of the month.
+	s, err := Parse("0 0 0 * * L")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	from := time.Date(2026, time.July, 1, 0, 0, 0, 0, time.UTC)
+	expected := time.Date(2026, time.July, 4, 0, 0, 0, 0, time.UTC)
+	if actual := s.Next(from); !actual.Equal(expected) {
+		t.Errorf("Next(%s) => expected %s, got %s", from, expected, actual)
+	}
+}
+
+func TestSpecScheduleNthWeekdayAccumulates(t *testing.T) {
+	// Repeating d#n for the same weekday should honor every occurrence
+	// requested, not just the last one parsed.
+	s, err := Parse("0 0 0 * * 2#1,2#3")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	entries := []struct {
+		from, expected time.Time
+	}{
+		{time.Date(2026, time.July, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, time.July, 7, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2026, time.July, 8, 0, 0, 0, 0, time.UTC), time.Date(2026, time.July, 21, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, c := range entries {
+		actual := s.Next(c.from)
+		if !actual.Equal(c.expected) {
+			t.Errorf("Next(%s) => expected %s, got %s", c.from, c.expected, actual)
+		}
+	}
+}
+
+func TestSpecScheduleLastWeekday(t *testing.T) {
+	// The last Friday of every month.
+	s, err := Parse("0 0 0 * * FRIL")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	from := time.Date(2026, time.July, 1, 0, 0, 0, 0, time.UTC)
+	expected := time.Date(2026, time.July, 31, 0, 0, 0, 0, time.UTC)
+	if actual := s.Next(from); !actual.Equal(expected) {
+		t.Errorf("Next(%s) => expected %s, got %s", from, expected, actual)
+	}
+}
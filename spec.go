@@ -0,0 +1,251 @@
+package gocron
+
+import "time"
+
+// Schedule describes a job's duty cycle.
+type Schedule interface {
+	// Next returns the next activation time, later than the given time.
+	// Next is invoked initially, and then each time the job is run.
+	Next(t time.Time) time.Time
+
+	// Prev returns the most recent activation time, earlier than the given
+	// time, or the zero time if none can be found within a bounded search
+	// (5 years). Useful for backfill/catch-up: finding the last time a job
+	// should have run.
+	Prev(t time.Time) time.Time
+}
+
+// specSchedule specifies a duty cycle (to the second granularity), based on a
+// traditional crontab specification. It is computed initially and stored as
+// bit sets.
+type specSchedule struct {
+	second, minute, hour, dom, month, dow uint64
+
+	// Quartz-style positional day constraints that can't be expressed as a
+	// bitset. These are consulted by dayMatches alongside dom/dow above.
+	domLast           bool           // dom field was "L" or "L-n"
+	domLastOffset     int            // the "n" in "L-n"; 0 for plain "L"
+	domNearestWeekday int            // the "d" in "dW"; 0 if unset
+	dowNth            map[uint]uint8 // weekday -> bitmask of requested occurrences (1-5), from "d#n"
+	dowLastMask       uint8          // bitmask of weekdays requested via "dL"
+
+	// Override location for this schedule.
+	location *time.Location
+}
+
+// bounds provides a range of acceptable values (plus a map of name to value).
+type bounds struct {
+	min, max uint
+	names    map[string]uint
+}
+
+// The bounds for each field.
+var (
+	seconds = bounds{0, 59, nil}
+	minutes = bounds{0, 59, nil}
+	hours   = bounds{0, 23, nil}
+	doms    = bounds{1, 31, nil}
+	months  = bounds{1, 12, map[string]uint{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	dows = bounds{0, 6, map[string]uint{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+// starBit is set to indicate that a field's expression was a "*", so it
+// always matches, regardless of the other matches.
+const starBit = 1 << 63
+
+// Next returns the next time this schedule is activated, greater than the
+// given time. If no time can be found to satisfy the schedule, return the
+// zero time.
+func (s *specSchedule) Next(t time.Time) time.Time {
+	// General approach:
+	//
+	// For Month, Day, Hour, Minute, Second:
+	// Check if the time value matches. If yes, continue to the next field.
+	// If the field doesn't match the schedule, then increment the field until
+	// it matches. While incrementing the field, a wrap-around brings it back
+	// to the beginning of the field list (since it is necessary to re-verify
+	// previous field values).
+
+	// Convert the given time into the schedule's timezone, if one is
+	// specified.
+	origLocation := t.Location()
+	loc := s.location
+	if loc == time.Local {
+		loc = t.Location()
+	}
+	if s.location != time.Local {
+		loc = s.location
+	}
+	t = t.In(loc)
+
+	// Start at the earliest possible time (the upcoming second).
+	t = t.Add(1*time.Second - time.Duration(t.Nanosecond())*time.Nanosecond)
+
+	added := false
+	yearLimit := t.Year() + 5
+
+WRAP:
+	if t.Year() > yearLimit {
+		return time.Time{}
+	}
+
+	for 1<<uint(t.Month())&s.month == 0 {
+		if !added {
+			added = true
+			t = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, loc)
+		}
+		t = t.AddDate(0, 1, 0)
+
+		if t.Month() == time.January {
+			goto WRAP
+		}
+	}
+
+	for !dayMatches(s, t) {
+		if !added {
+			added = true
+			t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+		}
+		t = t.AddDate(0, 0, 1)
+
+		if t.Day() == 1 {
+			goto WRAP
+		}
+	}
+
+	for 1<<uint(t.Hour())&s.hour == 0 {
+		if !added {
+			added = true
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, loc)
+		}
+		t = t.Add(1 * time.Hour)
+
+		if t.Hour() == 0 {
+			goto WRAP
+		}
+	}
+
+	for 1<<uint(t.Minute())&s.minute == 0 {
+		if !added {
+			added = true
+			t = t.Truncate(time.Minute)
+		}
+		t = t.Add(1 * time.Minute)
+
+		if t.Minute() == 0 {
+			goto WRAP
+		}
+	}
+
+	for 1<<uint(t.Second())&s.second == 0 {
+		if !added {
+			added = true
+			t = t.Truncate(time.Second)
+		}
+		t = t.Add(1 * time.Second)
+
+		if t.Second() == 0 {
+			goto WRAP
+		}
+	}
+
+	return t.In(origLocation)
+}
+
+// Prev returns the most recent time this schedule was activated, earlier
+// than the given time. It is the mirror image of Next: the same bit-field
+// search, walking backwards and landing on the latest moment (23:59:59, not
+// 00:00:00) of whichever coarser field it has to roll back across. If no
+// activation can be found within 5 years, return the zero time.
+func (s *specSchedule) Prev(t time.Time) time.Time {
+	origLocation := t.Location()
+	loc := s.location
+	if loc == time.Local {
+		loc = t.Location()
+	}
+	if s.location != time.Local {
+		loc = s.location
+	}
+	t = t.In(loc)
+
+	// Start at the latest possible time strictly before the given time.
+	t = t.Add(-1*time.Second - time.Duration(t.Nanosecond())*time.Nanosecond)
+
+	yearLimit := t.Year() - 5
+
+WRAP:
+	if t.Year() < yearLimit {
+		return time.Time{}
+	}
+
+	for 1<<uint(t.Month())&s.month == 0 {
+		year, month := t.Year(), t.Month()-1
+		if month == 0 {
+			month = time.December
+			year--
+		}
+		t = time.Date(year, month, daysInMonth(year, month), 23, 59, 59, 0, loc)
+
+		if month == time.December {
+			goto WRAP
+		}
+	}
+
+	for !dayMatches(s, t) {
+		t = time.Date(t.Year(), t.Month(), t.Day()-1, 23, 59, 59, 0, loc)
+
+		if t.Day() == daysInMonth(t.Year(), t.Month()) {
+			goto WRAP
+		}
+	}
+
+	for 1<<uint(t.Hour())&s.hour == 0 {
+		t = t.Add(-1 * time.Hour)
+		t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 59, 59, 0, loc)
+
+		if t.Hour() == 23 {
+			goto WRAP
+		}
+	}
+
+	for 1<<uint(t.Minute())&s.minute == 0 {
+		t = t.Add(-1 * time.Minute)
+		t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), 59, 0, loc)
+
+		if t.Minute() == 59 {
+			goto WRAP
+		}
+	}
+
+	for 1<<uint(t.Second())&s.second == 0 {
+		t = t.Add(-1 * time.Second)
+
+		if t.Second() == 59 {
+			goto WRAP
+		}
+	}
+
+	return t.In(origLocation)
+}
+
+// dayMatches returns true if the schedule's dom and dow fields match the
+// given time. If either the dom or dow field is a "*", the two fields are
+// AND'd together (so only the other field restricts the day); otherwise they
+// are OR'd. The Quartz
+// extensions (L, W, #) are consulted alongside the plain bitsets.
+func dayMatches(s *specSchedule, t time.Time) bool {
+	var (
+		domMatch = 1<<uint(t.Day())&s.dom > 0 || domSpecialMatches(s, t)
+		dowMatch = 1<<uint(t.Weekday())&s.dow > 0 || dowSpecialMatches(s, t)
+	)
+
+	if s.dom&starBit > 0 || s.dow&starBit > 0 {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
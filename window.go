@@ -0,0 +1,18 @@
+package gocron
+
+import "time"
+
+// Window wraps a Schedule with convenience methods for enumerating its
+// activations over a span of time, rather than one at a time via Next/Prev.
+type Window struct {
+	Schedule Schedule
+}
+
+// Between returns every activation time in [from, to], in ascending order.
+func (w Window) Between(from, to time.Time) []time.Time {
+	var times []time.Time
+	for t := w.Schedule.Next(from.Add(-time.Nanosecond)); !t.IsZero() && !t.After(to); t = w.Schedule.Next(t) {
+		times = append(times, t)
+	}
+	return times
+}